@@ -1,17 +1,28 @@
 package ft_config
 
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
 // ConfigMapping defines the mapping between environment variables and config field names.
 // Key: The name you want to use in your code (e.g., "SupabaseKey")
 // Value: The environment variable name in your .env file (e.g., "SUPABASE_ANON_KEY")
 type ConfigMapping map[string]string
 
+// redactedValue replaces the real value of any key marked secret in String,
+// MarshalJSON, GetAllSafe, and log output.
+const redactedValue = "***"
+
 // Config represents your application configuration.
 // This struct is dynamically populated based on the ConfigMapping you provide.
 // You can access values in two ways:
 //   1. Direct field access: cfg.SupabaseKey
 //   2. Dynamic access: cfg.Get("SupabaseKey")
 type Config struct {
-	values map[string]string
+	values  map[string]string
+	secrets map[string]struct{}
 }
 
 // Get retrieves a configuration value by key.
@@ -57,4 +68,68 @@ func (c *Config) GetAll() map[string]string {
 // This is used internally by accessor methods.
 func (c *Config) GetValue(key string) string {
 	return c.values[key]
+}
+
+// GetAllSafe returns all configuration key-value pairs, with any value
+// marked secret replaced by a redacted placeholder.
+func (c *Config) GetAllSafe() map[string]string {
+	result := make(map[string]string, len(c.values))
+	for k, v := range c.values {
+		result[k] = c.logValue(k, v)
+	}
+	return result
+}
+
+// String returns a human-readable representation of the configuration, with
+// any value marked secret redacted. It implements fmt.Stringer so Config
+// never leaks secrets through %v/%s formatting or accidental logging.
+func (c *Config) String() string {
+	keys := make([]string, 0, len(c.values))
+	for k := range c.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("Config{")
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(k)
+		b.WriteString(": ")
+		b.WriteString(c.logValue(k, c.values[k]))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// MarshalJSON implements json.Marshaler, redacting any value marked secret
+// so Config can be marshaled without leaking credentials.
+func (c *Config) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.GetAllSafe())
+}
+
+// markSecret flags key as holding a secret value.
+func (c *Config) markSecret(key string) {
+	if c.secrets == nil {
+		c.secrets = make(map[string]struct{})
+	}
+	c.secrets[key] = struct{}{}
+}
+
+// isSecret reports whether key was flagged via markSecret.
+func (c *Config) isSecret(key string) bool {
+	_, ok := c.secrets[key]
+	return ok
+}
+
+// logValue returns value, or the redacted placeholder if key is marked
+// secret. Call sites use this instead of the raw value whenever a value
+// might end up in a log line, string representation, or JSON document.
+func (c *Config) logValue(key, value string) string {
+	if c.isSecret(key) {
+		return redactedValue
+	}
+	return value
 }
\ No newline at end of file
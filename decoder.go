@@ -0,0 +1,249 @@
+package ft_config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Load decodes the environment variables from the .env file at path directly
+// into out, which must be a pointer to a struct. Fields are matched via the
+// `env` struct tag:
+//
+//	Port  string `env:"PORT"`                // required, no default
+//	Host  string `env:"HOST,default=0.0.0.0"` // falls back when HOST is unset
+//	Debug bool   `env:"DEBUG,required"`       // explicit required marker
+//	Key   string `env:"SUPABASE_KEY,secret"`  // see SecretKeys
+//
+// Values are converted to the field's type: string, int/int64, float64, bool,
+// time.Duration, and []string (split on commas). Nested structs are walked
+// recursively; tag the nested field with `envPrefix:"DB_"` to namespace its
+// variables. Fields without an `env` tag, and unexported fields, are left
+// untouched.
+//
+// Load decodes into a plain struct, not a Config/Service, so a `secret`
+// option does not redact anything by itself; call SecretKeys(out) after a
+// successful Load to get the environment variable names it was given, and
+// feed them to something like Service.MarkSecret for the matching config
+// keys.
+//
+// A field may also carry a `validate` tag (e.g.
+// `validate:"required,oneof=dev staging prod,url"`), checked with the same
+// logic as FieldRule/SetSchema. The tag supports required, url, oneof=,
+// pattern=, minlen=, and maxlen=; FieldRule's IntRange and Custom have no
+// tag form. Violations from every field are collected into a single
+// *ValidationError, which Load returns only when no variable is missing.
+//
+// If any field has no value in the environment and no default, Load collects
+// all such keys and returns a single aggregated error.
+func Load(path string, out any) error {
+	logInfof("Load", "Decoding environment variables from: %s", path)
+
+	if err := godotenv.Load(path); err != nil {
+		logError("Load", err)
+		return ErrLoadEnv
+	}
+
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("ft_config: Load requires a non-nil pointer to a struct, got %T", out)
+	}
+
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("ft_config: Load requires a pointer to a struct, got %T", out)
+	}
+
+	var missing []string
+	var violations []*FieldViolation
+	if err := decodeStruct(v, "", &missing, &violations); err != nil {
+		return err
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required environment variables: %s", strings.Join(missing, ", "))
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+
+	logInfof("Load", "Successfully decoded into %s", v.Type())
+	return nil
+}
+
+// decodeStruct walks the fields of v, populating them from the environment,
+// appending the keys of any missing, default-less fields to missing, and any
+// `validate` tag failures to violations.
+func decodeStruct(v reflect.Value, prefix string, missing *[]string, violations *[]*FieldViolation) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if !fv.CanSet() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Struct && field.Type != durationType {
+			nestedPrefix := prefix + field.Tag.Get("envPrefix")
+			if err := decodeStruct(fv, nestedPrefix, missing, violations); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+
+		envKey, opts := parseEnvTag(tag)
+		envKey = prefix + envKey
+
+		value, exists := os.LookupEnv(envKey)
+		if !exists {
+			if def, hasDefault := opts["default"]; hasDefault {
+				value = def
+			} else {
+				*missing = append(*missing, envKey)
+				continue
+			}
+		}
+
+		if err := setField(fv, value); err != nil {
+			return fmt.Errorf("ft_config: field %s (%s): %w", field.Name, envKey, err)
+		}
+
+		if validateTag, ok := field.Tag.Lookup("validate"); ok {
+			rule, err := parseValidateTag(validateTag)
+			if err != nil {
+				return err
+			}
+			*violations = append(*violations, validateField(envKey, value, true, rule)...)
+		}
+	}
+
+	return nil
+}
+
+// SecretKeys returns the environment variable name of every field in out
+// tagged with the "secret" env option (env:"SUPABASE_KEY,secret"), including
+// those nested under an envPrefix struct. out must be the same pointer to a
+// struct (or a value of that struct type) passed to Load.
+func SecretKeys(out any) []string {
+	v := reflect.ValueOf(out)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var keys []string
+	collectSecretKeys(v, "", &keys)
+	return keys
+}
+
+// collectSecretKeys walks v's fields, appending the environment variable
+// name of each one tagged env:"...,secret" to keys.
+func collectSecretKeys(v reflect.Value, prefix string, keys *[]string) {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+
+		if !fv.CanSet() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Struct && field.Type != durationType {
+			collectSecretKeys(fv, prefix+field.Tag.Get("envPrefix"), keys)
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+
+		envKey, opts := parseEnvTag(tag)
+		if _, secret := opts["secret"]; secret {
+			*keys = append(*keys, prefix+envKey)
+		}
+	}
+}
+
+// parseEnvTag splits a struct tag value such as "PORT,default=8080,required"
+// into the environment variable name and its options.
+func parseEnvTag(tag string) (string, map[string]string) {
+	parts := strings.Split(tag, ",")
+	opts := make(map[string]string, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		if key, value, found := strings.Cut(part, "="); found {
+			opts[key] = value
+		} else {
+			opts[part] = ""
+		}
+	}
+
+	return parts[0], opts
+}
+
+// setField converts value to fv's type and assigns it.
+func setField(fv reflect.Value, value string) error {
+	switch {
+	case fv.Type() == durationType:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(int64(d))
+
+	case fv.Kind() == reflect.String:
+		fv.SetString(value)
+
+	case fv.Kind() == reflect.Int || fv.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+
+	case fv.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+
+	case fv.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+		items := strings.Split(value, ",")
+		for i := range items {
+			items[i] = strings.TrimSpace(items[i])
+		}
+		fv.Set(reflect.ValueOf(items))
+
+	default:
+		return fmt.Errorf("unsupported field type %s", fv.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package ft_config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeDecoderFixture(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing fixture .env: %v", err)
+	}
+	return path
+}
+
+func TestDecodeTypedFields(t *testing.T) {
+	var (
+		testName     = "TestDecodeTypedFields"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Load with typed fields\n")
+	output.WriteString("========================================\n")
+
+	type TypedConfig struct {
+		Port    int           `env:"DECODER_PORT"`
+		Ratio   float64       `env:"DECODER_RATIO"`
+		Debug   bool          `env:"DECODER_DEBUG"`
+		Timeout time.Duration `env:"DECODER_TIMEOUT"`
+		Tags    []string      `env:"DECODER_TAGS"`
+	}
+
+	path := writeDecoderFixture(t, "DECODER_PORT=9090\n"+
+		"DECODER_RATIO=0.5\n"+
+		"DECODER_DEBUG=true\n"+
+		"DECODER_TIMEOUT=2s\n"+
+		"DECODER_TAGS=a,b,c\n")
+
+	var config TypedConfig
+	if err := Load(path, &config); err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Fatalf("%s", errorMessage)
+		return
+	}
+
+	if config.Port != 9090 {
+		errorMessage = fmt.Sprintf("Port: expected 9090, got %d", config.Port)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if config.Ratio != 0.5 {
+		errorMessage = fmt.Sprintf("Ratio: expected 0.5, got %v", config.Ratio)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if config.Debug != true {
+		errorMessage = fmt.Sprintf("Debug: expected true, got %v", config.Debug)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if config.Timeout != 2*time.Second {
+		errorMessage = fmt.Sprintf("Timeout: expected 2s, got %v", config.Timeout)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if len(config.Tags) != 3 || config.Tags[0] != "a" || config.Tags[1] != "b" || config.Tags[2] != "c" {
+		errorMessage = fmt.Sprintf("Tags: expected [a b c], got %v", config.Tags)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Load() converts int, float64, bool, time.Duration, and []string fields\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestDecodeDefaultOption(t *testing.T) {
+	var (
+		testName     = "TestDecodeDefaultOption"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Load with a default= tag option\n")
+	output.WriteString("========================================\n")
+
+	type ConfigWithDefault struct {
+		Host string `env:"DECODER_HOST,default=0.0.0.0"`
+	}
+
+	path := writeDecoderFixture(t, "")
+
+	var config ConfigWithDefault
+	if err := Load(path, &config); err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Fatalf("%s", errorMessage)
+		return
+	}
+
+	if config.Host != "0.0.0.0" {
+		errorMessage = fmt.Sprintf("Host: expected '0.0.0.0', got '%s'", config.Host)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Load() falls back to a field's default when the variable is unset\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestDecodeSecretKeys(t *testing.T) {
+	var (
+		testName     = "TestDecodeSecretKeys"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing SecretKeys after Load\n")
+	output.WriteString("========================================\n")
+
+	type SecretConfig struct {
+		SupabaseKey string `env:"DECODER_SUPABASE_KEY,secret"`
+		Host        string `env:"DECODER_HOST2"`
+	}
+
+	path := writeDecoderFixture(t, "DECODER_SUPABASE_KEY=s3cr3t\nDECODER_HOST2=localhost\n")
+
+	var config SecretConfig
+	if err := Load(path, &config); err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Fatalf("%s", errorMessage)
+		return
+	}
+
+	keys := SecretKeys(&config)
+	if len(keys) != 1 || keys[0] != "DECODER_SUPABASE_KEY" {
+		errorMessage = fmt.Sprintf("expected [DECODER_SUPABASE_KEY], got %v", keys)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ SecretKeys() reports the env var names tagged with the secret option\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestDecodeValidateTagRejectsInvalidValue(t *testing.T) {
+	var (
+		testName     = "TestDecodeValidateTagRejectsInvalidValue"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Load with a validate tag\n")
+	output.WriteString("========================================\n")
+
+	type ConfigWithValidation struct {
+		Env string `env:"DECODER_ENV" validate:"required,oneof=dev staging prod"`
+	}
+
+	path := writeDecoderFixture(t, "DECODER_ENV=qa\n")
+
+	var config ConfigWithValidation
+	err := Load(path, &config)
+	if err == nil {
+		errorMessage = "expected Load() to reject a value outside the validate tag's oneof"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		errorMessage = fmt.Sprintf("expected a *ValidationError, got: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	var violation *FieldViolation
+	if !errors.As(err, &violation) || violation.Key != "DECODER_ENV" {
+		errorMessage = fmt.Sprintf("expected a *FieldViolation for DECODER_ENV, got: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Load() honors a field's validate tag and aggregates failures into a *ValidationError\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestDecodeNestedEnvPrefix(t *testing.T) {
+	var (
+		testName     = "TestDecodeNestedEnvPrefix"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Load with a nested envPrefix struct\n")
+	output.WriteString("========================================\n")
+
+	type DBConfig struct {
+		URL string `env:"URL"`
+	}
+
+	type NestedConfig struct {
+		DB DBConfig `envPrefix:"DECODER_DB_"`
+	}
+
+	path := writeDecoderFixture(t, "DECODER_DB_URL=postgres://localhost/nested\n")
+
+	var config NestedConfig
+	if err := Load(path, &config); err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Fatalf("%s", errorMessage)
+		return
+	}
+
+	if config.DB.URL != "postgres://localhost/nested" {
+		errorMessage = fmt.Sprintf("DB.URL: expected 'postgres://localhost/nested', got '%s'", config.DB.URL)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Load() namespaces a nested struct's fields with its envPrefix\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
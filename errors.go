@@ -18,4 +18,9 @@ var (
 
 	// ErrNoMapping is returned when no configuration mapping is provided.
 	ErrNoMapping = errors.New("no configuration mapping provided")
+
+	// ErrNoProviders is returned when LoadFromProviders is called on a
+	// service that has no providers registered (one created with New, or
+	// with NewWithProviders and no providers).
+	ErrNoProviders = errors.New("no configuration providers registered")
 )
\ No newline at end of file
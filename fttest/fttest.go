@@ -0,0 +1,121 @@
+// Package fttest provides test helpers for code that uses ft_config:
+// golden-file snapshots of a loaded Service, and scratch .env fixtures that
+// clean up after themselves.
+package fttest
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	ft_config "github.com/Cleroy288/ft_config"
+)
+
+var update = flag.Bool("update", false, "update golden snapshot files")
+
+// Snapshot serializes svc.GetAllSafe() (sorted, with secret keys redacted)
+// and compares it against testdata/snapshots/<name>.golden, failing the
+// test on a mismatch. Run `go test -update` to (re)write the golden file.
+//
+// Example:
+//
+//	func TestLoad(t *testing.T) {
+//	    svc := ft_config.New(mapping)
+//	    if err := svc.Load(fttest.TempEnvFile(t, ".env contents")); err != nil {
+//	        t.Fatal(err)
+//	    }
+//	    fttest.Snapshot(t, svc, "basic")
+//	}
+func Snapshot(t *testing.T, svc *ft_config.Service, name string) {
+	t.Helper()
+
+	values := svc.GetAllSafe()
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sorted := make(map[string]string, len(values))
+	for _, k := range keys {
+		sorted[k] = values[k]
+	}
+
+	data, err := json.MarshalIndent(sorted, "", "  ")
+	if err != nil {
+		t.Fatalf("fttest: marshaling snapshot: %v", err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join("testdata", "snapshots", name+".golden")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("fttest: creating snapshot directory: %v", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatalf("fttest: writing snapshot: %v", err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("fttest: reading snapshot %s (run with -update to create it): %v", path, err)
+	}
+
+	if string(golden) != string(data) {
+		t.Errorf("fttest: snapshot %s does not match.\n--- got ---\n%s\n--- want ---\n%s", path, data, golden)
+	}
+}
+
+// WithEnv sets the given environment variables for the duration of the
+// test, restoring each one (or unsetting it, if it wasn't previously set)
+// via t.Cleanup.
+//
+// Example:
+//
+//	fttest.WithEnv(t, map[string]string{"PORT": "9090"})
+func WithEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+
+	for key, value := range env {
+		key, value := key, value
+
+		previous, existed := os.LookupEnv(key)
+		if err := os.Setenv(key, value); err != nil {
+			t.Fatalf("fttest: setting %s: %v", key, err)
+		}
+
+		t.Cleanup(func() {
+			if existed {
+				os.Setenv(key, previous)
+			} else {
+				os.Unsetenv(key)
+			}
+		})
+	}
+}
+
+// TempEnvFile writes contents to a .env file in a new temporary directory
+// and returns its path. The directory is removed automatically when the
+// test completes.
+//
+// Example:
+//
+//	path := fttest.TempEnvFile(t, "PORT=8080\n")
+//	err := svc.Load(path)
+func TempEnvFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".env")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("fttest: writing temp .env file: %v", err)
+	}
+
+	return path
+}
@@ -0,0 +1,68 @@
+package fttest
+
+import (
+	"os"
+	"testing"
+
+	ft_config "github.com/Cleroy288/ft_config"
+)
+
+func TestWithEnvSetsAndRestores(t *testing.T) {
+	os.Setenv("FTTEST_EXISTING", "before")
+	defer os.Unsetenv("FTTEST_EXISTING")
+
+	t.Run("scoped", func(t *testing.T) {
+		WithEnv(t, map[string]string{
+			"FTTEST_EXISTING": "during",
+			"FTTEST_NEW":      "value",
+		})
+
+		if os.Getenv("FTTEST_EXISTING") != "during" {
+			t.Fatalf("expected FTTEST_EXISTING=during, got %q", os.Getenv("FTTEST_EXISTING"))
+		}
+		if os.Getenv("FTTEST_NEW") != "value" {
+			t.Fatalf("expected FTTEST_NEW=value, got %q", os.Getenv("FTTEST_NEW"))
+		}
+	})
+
+	if os.Getenv("FTTEST_EXISTING") != "before" {
+		t.Fatalf("expected FTTEST_EXISTING restored to 'before', got %q", os.Getenv("FTTEST_EXISTING"))
+	}
+	if _, exists := os.LookupEnv("FTTEST_NEW"); exists {
+		t.Fatal("expected FTTEST_NEW to be unset after the subtest")
+	}
+}
+
+func TestTempEnvFile(t *testing.T) {
+	path := TempEnvFile(t, "PORT=8080\n")
+
+	mapping := ft_config.ConfigMapping{"Port": "PORT"}
+	service := ft_config.New(mapping)
+
+	if err := service.Load(path); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	port, err := service.Get("Port")
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	if port != "8080" {
+		t.Fatalf("expected Port=8080, got %q", port)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	mapping := ft_config.ConfigMapping{"Port": "PORT", "SupabaseKey": "SUPABASE_KEY"}
+	service := ft_config.New(mapping)
+
+	if err := service.Set("Port", "8080"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if err := service.Set("SupabaseKey", "super-secret"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	service.MarkSecret("SupabaseKey")
+
+	Snapshot(t, service, "example")
+}
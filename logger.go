@@ -3,77 +3,149 @@ package ft_config
 import (
 	"fmt"
 	"log"
+	"log/slog"
 	"sync"
 )
 
-// Logger manages logging for the ft_config package.
-type Logger struct {
+// Logger receives structured log events emitted by a Service. ctx is a
+// short label identifying the operation that produced the event (e.g.
+// "Load", "Set"), matching the context labels this package has always used.
+// kv is an alternating sequence of key/value pairs, e.g.
+// Info("Load", "loaded configuration", "file", path, "loaded", 4, "total", 6).
+type Logger interface {
+	Info(ctx, msg string, kv ...any)
+	Error(ctx string, err error, kv ...any)
+}
+
+// NoopLogger discards every log event. Pass it to Service.SetLogger to
+// silence a single Service's Load, Set, Delete, and Clear events regardless
+// of the package-level EnableLogging/DisableLogging switch.
+type NoopLogger struct{}
+
+// Info implements Logger.
+func (NoopLogger) Info(ctx, msg string, kv ...any) {}
+
+// Error implements Logger.
+func (NoopLogger) Error(ctx string, err error, kv ...any) {}
+
+// loggingSwitch backs the package-level EnableLogging/DisableLogging toggle
+// that stdLogger, the default Logger, consults before writing anything.
+type loggingSwitch struct {
 	enabled bool
 	mu      sync.RWMutex
 }
 
-// globalLogger is the package-level logger instance.
-var globalLogger = &Logger{
-	enabled: false, // Disabled by default for production use
-}
+// globalSwitch is the package's logging on/off state, disabled by default
+// for production use.
+var globalSwitch = &loggingSwitch{enabled: false}
 
-// EnableLogging enables logging for the ft_config package.
+// EnableLogging enables logging for the ft_config package's default Logger.
+// It has no effect on a Service that was given a Logger via SetLogger.
 func EnableLogging() {
-	globalLogger.mu.Lock()
-	defer globalLogger.mu.Unlock()
-	globalLogger.enabled = true
+	globalSwitch.mu.Lock()
+	defer globalSwitch.mu.Unlock()
+	globalSwitch.enabled = true
 }
 
-// DisableLogging disables logging for the ft_config package.
+// DisableLogging disables logging for the ft_config package's default
+// Logger. It has no effect on a Service that was given a Logger via
+// SetLogger.
 func DisableLogging() {
-	globalLogger.mu.Lock()
-	defer globalLogger.mu.Unlock()
-	globalLogger.enabled = false
+	globalSwitch.mu.Lock()
+	defer globalSwitch.mu.Unlock()
+	globalSwitch.enabled = false
 }
 
-// IsLoggingEnabled returns whether logging is currently enabled.
+// IsLoggingEnabled returns whether the package's default Logger is
+// currently enabled.
 func IsLoggingEnabled() bool {
-	globalLogger.mu.RLock()
-	defer globalLogger.mu.RUnlock()
-	return globalLogger.enabled
+	globalSwitch.mu.RLock()
+	defer globalSwitch.mu.RUnlock()
+	return globalSwitch.enabled
 }
 
-// logInfo logs an informational message with context.
-func logInfo(context, message string) {
-	globalLogger.mu.RLock()
-	enabled := globalLogger.enabled
-	globalLogger.mu.RUnlock()
+// stdLogger is the package's default Logger: it writes through log.Printf,
+// gated by EnableLogging/DisableLogging, and formats kv pairs as
+// "key=value" suffixes. It is what every Service uses until SetLogger is
+// called.
+type stdLogger struct{}
 
-	if !enabled {
+// defaultLogger is the Logger a Service falls back to when SetLogger has
+// never been called, and what the package-level logInfo/logInfof/logError
+// helpers use internally.
+var defaultLogger Logger = stdLogger{}
+
+// Info implements Logger.
+func (stdLogger) Info(ctx, msg string, kv ...any) {
+	if !IsLoggingEnabled() {
 		return
 	}
+	log.Printf("[ft_config] [%s] %s%s", ctx, msg, formatKV(kv))
+}
 
-	log.Printf("[ft_config] [%s] %s", context, message)
+// Error implements Logger.
+func (stdLogger) Error(ctx string, err error, kv ...any) {
+	if !IsLoggingEnabled() {
+		return
+	}
+	log.Printf("[ft_config] [%s] ERROR: %v%s", ctx, err, formatKV(kv))
 }
 
-// logInfof logs a formatted informational message with context.
-func logInfof(context, format string, args ...any) {
-	globalLogger.mu.RLock()
-	enabled := globalLogger.enabled
-	globalLogger.mu.RUnlock()
+// formatKV renders an alternating key/value slice as " key=value key=value"
+// for appending to a log line, or "" if kv is empty. A trailing key with no
+// value is dropped.
+func formatKV(kv []any) string {
+	if len(kv) == 0 {
+		return ""
+	}
 
-	if !enabled {
-		return
+	out := ""
+	for i := 0; i+1 < len(kv); i += 2 {
+		out += fmt.Sprintf(" %v=%v", kv[i], kv[i+1])
 	}
+	return out
+}
 
-	message := fmt.Sprintf(format, args...)
-	log.Printf("[ft_config] [%s] %s", context, message)
+// slogLogger adapts a *slog.Logger to this package's Logger interface.
+type slogLogger struct {
+	logger *slog.Logger
 }
 
-// logError logs an error message with context.
-func logError(context string, err error) {
-	globalLogger.mu.RLock()
-	enabled := globalLogger.enabled
-	globalLogger.mu.RUnlock()
+// NewSlogLogger returns a Logger that forwards every event to logger,
+// keyed under the "ctx" attribute alongside the kv pairs passed to Info
+// and Error.
+//
+// Example:
+//
+//	service.SetLogger(ft_config.NewSlogLogger(slog.Default()))
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return &slogLogger{logger: logger}
+}
 
-	if !enabled {
-		return
-	}
+// Info implements Logger.
+func (l *slogLogger) Info(ctx, msg string, kv ...any) {
+	l.logger.Info(msg, append([]any{"ctx", ctx}, kv...)...)
+}
+
+// Error implements Logger.
+func (l *slogLogger) Error(ctx string, err error, kv ...any) {
+	l.logger.Error(err.Error(), append([]any{"ctx", ctx}, kv...)...)
+}
+
+// logInfo logs an informational message through the package's default
+// Logger, for package-level code that is not tied to any particular
+// Service.
+func logInfo(ctx, msg string) {
+	defaultLogger.Info(ctx, msg)
+}
 
-	log.Printf("[ft_config] [%s] ERROR: %v", context, err)
-}
\ No newline at end of file
+// logInfof logs a formatted informational message through the package's
+// default Logger.
+func logInfof(ctx, format string, args ...any) {
+	defaultLogger.Info(ctx, fmt.Sprintf(format, args...))
+}
+
+// logError logs an error through the package's default Logger.
+func logError(ctx string, err error) {
+	defaultLogger.Error(ctx, err)
+}
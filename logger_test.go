@@ -0,0 +1,113 @@
+package ft_config
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"testing"
+)
+
+// capturingLogger records the most recent Info call and the most recent
+// Error call it received, for asserting what a Service logs without
+// depending on log.Printf output.
+type capturingLogger struct {
+	infoCtx string
+	infoMsg string
+	infoKV  []any
+	errCtx  string
+	errErr  error
+}
+
+func (l *capturingLogger) Info(ctx, msg string, kv ...any) {
+	l.infoCtx, l.infoMsg, l.infoKV = ctx, msg, kv
+}
+
+func (l *capturingLogger) Error(ctx string, err error, kv ...any) {
+	l.errCtx, l.errErr = ctx, err
+}
+
+func TestServiceSetLoggerReceivesStructuredFields(t *testing.T) {
+	var (
+		testName     = "TestServiceSetLoggerReceivesStructuredFields"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Service.SetLogger\n")
+	output.WriteString("========================================\n")
+
+	mapping := ConfigMapping{"Port": "PORT"}
+	service := New(mapping)
+
+	logger := &capturingLogger{}
+	service.SetLogger(logger)
+
+	if err := service.Set("Port", "8080"); err != nil {
+		errorMessage = fmt.Sprintf("Set() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if logger.infoCtx != "Set" {
+		errorMessage = fmt.Sprintf("expected ctx \"Set\", got %q", logger.infoCtx)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if len(logger.infoKV) < 2 || logger.infoKV[0] != "key" || logger.infoKV[1] != "Port" {
+		errorMessage = fmt.Sprintf("expected kv pairs starting with key=Port, got %v", logger.infoKV)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Service.SetLogger receives structured key/value fields from Set\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestServiceSetLoggerOverridesGlobalLoggingSwitch(t *testing.T) {
+	var (
+		testName     = "TestServiceSetLoggerOverridesGlobalLoggingSwitch"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing NoopLogger overrides EnableLogging\n")
+	output.WriteString("========================================\n")
+
+	mapping := ConfigMapping{"Port": "PORT"}
+	service := New(mapping)
+	service.SetLogger(NoopLogger{})
+
+	EnableLogging()
+	defer DisableLogging()
+
+	var logged bytes.Buffer
+	previousOutput := log.Writer()
+	log.SetOutput(&logged)
+	defer log.SetOutput(previousOutput)
+
+	if err := service.Set("Port", "8080"); err != nil {
+		errorMessage = fmt.Sprintf("Set() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if logged.Len() != 0 {
+		errorMessage = fmt.Sprintf("expected NoopLogger to write nothing while EnableLogging is on, got %q", logged.String())
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ a Service with NoopLogger writes nothing even with EnableLogging on\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
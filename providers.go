@@ -0,0 +1,203 @@
+package ft_config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Provider resolves configuration values for the keys declared in a
+// ConfigMapping. Implementations read from a single source (a file, the
+// process environment, command-line flags, ...); Service.LoadFromProviders
+// merges the results of several providers in order. A key the provider
+// cannot resolve is simply omitted from the returned map.
+//
+// mapping means different things to different providers:
+// EnvFileProvider and OSEnvProvider treat its values as environment
+// variable names, and FlagProvider uses its keys (the config keys) as flag
+// names. YAMLFileProvider and JSONFileProvider instead
+// read dotted document paths, which can't be expressed in a ConfigMapping,
+// so they ignore this argument and use their own FileMapping set at
+// construction time.
+type Provider interface {
+	Load(mapping ConfigMapping) (map[string]string, error)
+}
+
+// FileMapping maps configuration keys to dotted paths into a YAML or JSON
+// document, e.g. FileMapping{"DatabaseURL": "database.url"}. Unlike
+// ConfigMapping, whose values name an environment variable or flag, a
+// FileMapping's values are document paths understood only by
+// YAMLFileProvider and JSONFileProvider.
+type FileMapping map[string]string
+
+// EnvFileProvider loads values from a .env file, the same source used by
+// Service.Load.
+type EnvFileProvider struct {
+	Path string
+}
+
+// NewEnvFileProvider creates a Provider that reads from the .env file at path.
+func NewEnvFileProvider(path string) *EnvFileProvider {
+	return &EnvFileProvider{Path: path}
+}
+
+func (p *EnvFileProvider) Load(mapping ConfigMapping) (map[string]string, error) {
+	// Overload (rather than Load) so that re-running the provider - as
+	// Service.Watch does on every reload - picks up a changed file even
+	// though its variables are already present in the process environment.
+	if err := godotenv.Overload(p.Path); err != nil {
+		return nil, ErrLoadEnv
+	}
+
+	values := make(map[string]string, len(mapping))
+	for configKey, envKey := range mapping {
+		if value, exists := os.LookupEnv(envKey); exists {
+			values[configKey] = value
+		}
+	}
+
+	return values, nil
+}
+
+// OSEnvProvider reads values straight from the process environment, without
+// touching any file.
+type OSEnvProvider struct{}
+
+// NewOSEnvProvider creates a Provider backed by the process environment.
+func NewOSEnvProvider() *OSEnvProvider {
+	return &OSEnvProvider{}
+}
+
+func (p *OSEnvProvider) Load(mapping ConfigMapping) (map[string]string, error) {
+	values := make(map[string]string, len(mapping))
+	for configKey, envKey := range mapping {
+		if value, exists := os.LookupEnv(envKey); exists {
+			values[configKey] = value
+		}
+	}
+
+	return values, nil
+}
+
+// YAMLFileProvider loads values from a YAML file. Its Mapping's values are
+// dotted paths into the document, e.g. "database.url", rather than
+// environment variable names.
+type YAMLFileProvider struct {
+	Path    string
+	Mapping FileMapping
+}
+
+// NewYAMLFileProvider creates a Provider that reads from the YAML file at
+// path, resolving each dotted path in mapping.
+func NewYAMLFileProvider(path string, mapping FileMapping) *YAMLFileProvider {
+	return &YAMLFileProvider{Path: path, Mapping: mapping}
+}
+
+// Load implements Provider. The mapping argument is ignored; YAMLFileProvider
+// resolves its own FileMapping instead, since a ConfigMapping cannot express
+// dotted document paths.
+func (p *YAMLFileProvider) Load(_ ConfigMapping) (map[string]string, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("ft_config: reading YAML file %s: %w", p.Path, err)
+	}
+
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("ft_config: parsing YAML file %s: %w", p.Path, err)
+	}
+
+	return resolveDottedMapping(p.Mapping, doc), nil
+}
+
+// JSONFileProvider loads values from a JSON file. Its Mapping's values are
+// dotted paths into the document, e.g. "database.url", rather than
+// environment variable names.
+type JSONFileProvider struct {
+	Path    string
+	Mapping FileMapping
+}
+
+// NewJSONFileProvider creates a Provider that reads from the JSON file at
+// path, resolving each dotted path in mapping.
+func NewJSONFileProvider(path string, mapping FileMapping) *JSONFileProvider {
+	return &JSONFileProvider{Path: path, Mapping: mapping}
+}
+
+// Load implements Provider. The mapping argument is ignored; JSONFileProvider
+// resolves its own FileMapping instead, since a ConfigMapping cannot express
+// dotted document paths.
+func (p *JSONFileProvider) Load(_ ConfigMapping) (map[string]string, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("ft_config: reading JSON file %s: %w", p.Path, err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("ft_config: parsing JSON file %s: %w", p.Path, err)
+	}
+
+	return resolveDottedMapping(p.Mapping, doc), nil
+}
+
+// resolveDottedMapping walks doc for each dotted path in mapping's values,
+// returning the resolved string for every path that exists.
+func resolveDottedMapping(mapping FileMapping, doc map[string]any) map[string]string {
+	values := make(map[string]string, len(mapping))
+
+	for configKey, path := range mapping {
+		var node any = doc
+		found := true
+
+		for _, segment := range strings.Split(path, ".") {
+			m, ok := node.(map[string]any)
+			if !ok {
+				found = false
+				break
+			}
+			node, ok = m[segment]
+			if !ok {
+				found = false
+				break
+			}
+		}
+
+		if found && node != nil {
+			values[configKey] = fmt.Sprintf("%v", node)
+		}
+	}
+
+	return values
+}
+
+// FlagProvider binds mapping keys to flags on a flag.FlagSet, using the
+// config key as the flag name. Parse the FlagSet before calling Load.
+type FlagProvider struct {
+	FlagSet *flag.FlagSet
+}
+
+// NewFlagProvider creates a Provider backed by fs. fs must already be parsed
+// by the time Load is called.
+func NewFlagProvider(fs *flag.FlagSet) *FlagProvider {
+	return &FlagProvider{FlagSet: fs}
+}
+
+func (p *FlagProvider) Load(mapping ConfigMapping) (map[string]string, error) {
+	values := make(map[string]string, len(mapping))
+
+	for configKey := range mapping {
+		f := p.FlagSet.Lookup(configKey)
+		if f == nil || f.Value.String() == "" {
+			continue
+		}
+		values[configKey] = f.Value.String()
+	}
+
+	return values, nil
+}
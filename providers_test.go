@@ -0,0 +1,264 @@
+package ft_config
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvFileProviderLoadsMappedValues(t *testing.T) {
+	var (
+		testName     = "TestEnvFileProviderLoadsMappedValues"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing EnvFileProvider.Load\n")
+	output.WriteString("========================================\n")
+
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte("PORT=8080\n"), 0o644); err != nil {
+		t.Fatalf("writing fixture .env: %v", err)
+	}
+
+	// EnvFileProvider.Load sets PORT in the process environment through
+	// godotenv.Overload, and t.Setenv was never called for it, so nothing
+	// restores it automatically; clean up by hand.
+	t.Cleanup(func() { os.Unsetenv("PORT") })
+
+	provider := NewEnvFileProvider(path)
+	values, err := provider.Load(ConfigMapping{"Port": "PORT"})
+	if err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if values["Port"] != "8080" {
+		errorMessage = fmt.Sprintf("expected Port=8080, got %q", values["Port"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ EnvFileProvider resolves mapped keys from the .env file\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestOSEnvProviderLoadsMappedValues(t *testing.T) {
+	var (
+		testName     = "TestOSEnvProviderLoadsMappedValues"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing OSEnvProvider.Load\n")
+	output.WriteString("========================================\n")
+
+	t.Setenv("PROVIDERS_TEST_HOST", "localhost")
+
+	provider := NewOSEnvProvider()
+	values, err := provider.Load(ConfigMapping{"Host": "PROVIDERS_TEST_HOST"})
+	if err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if values["Host"] != "localhost" {
+		errorMessage = fmt.Sprintf("expected Host=localhost, got %q", values["Host"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ OSEnvProvider resolves mapped keys from the process environment\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestFlagProviderLoadsBoundFlags(t *testing.T) {
+	var (
+		testName     = "TestFlagProviderLoadsBoundFlags"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing FlagProvider.Load\n")
+	output.WriteString("========================================\n")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("Port", "", "")
+	if err := fs.Parse([]string{"-Port=9090"}); err != nil {
+		t.Fatalf("parsing flags: %v", err)
+	}
+
+	provider := NewFlagProvider(fs)
+	values, err := provider.Load(ConfigMapping{"Port": "PORT"})
+	if err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if values["Port"] != "9090" {
+		errorMessage = fmt.Sprintf("expected Port=9090, got %q", values["Port"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ FlagProvider resolves values bound to flags named after the config key\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestYAMLFileProviderResolvesDottedPaths(t *testing.T) {
+	var (
+		testName     = "TestYAMLFileProviderResolvesDottedPaths"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing YAMLFileProvider.Load\n")
+	output.WriteString("========================================\n")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	contents := "database:\n  url: postgres://localhost/app\n"
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing fixture YAML: %v", err)
+	}
+
+	provider := NewYAMLFileProvider(path, FileMapping{"DatabaseURL": "database.url"})
+	values, err := provider.Load(nil)
+	if err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if values["DatabaseURL"] != "postgres://localhost/app" {
+		errorMessage = fmt.Sprintf("expected DatabaseURL=postgres://localhost/app, got %q", values["DatabaseURL"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ YAMLFileProvider resolves dotted paths from its own FileMapping\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestJSONFileProviderResolvesDottedPaths(t *testing.T) {
+	var (
+		testName     = "TestJSONFileProviderResolvesDottedPaths"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing JSONFileProvider.Load\n")
+	output.WriteString("========================================\n")
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	contents := `{"database": {"url": "postgres://localhost/app"}}`
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing fixture JSON: %v", err)
+	}
+
+	provider := NewJSONFileProvider(path, FileMapping{"DatabaseURL": "database.url"})
+	values, err := provider.Load(nil)
+	if err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if values["DatabaseURL"] != "postgres://localhost/app" {
+		errorMessage = fmt.Sprintf("expected DatabaseURL=postgres://localhost/app, got %q", values["DatabaseURL"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ JSONFileProvider resolves dotted paths from its own FileMapping\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestServiceLoadFromProvidersAppliesOverridePrecedence(t *testing.T) {
+	var (
+		testName     = "TestServiceLoadFromProvidersAppliesOverridePrecedence"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Service.LoadFromProviders override precedence\n")
+	output.WriteString("========================================\n")
+
+	yamlPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(yamlPath, []byte("port: \"8080\"\n"), 0o644); err != nil {
+		t.Fatalf("writing fixture YAML: %v", err)
+	}
+
+	envPath := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(envPath, []byte("PORT=9090\n"), 0o644); err != nil {
+		t.Fatalf("writing fixture .env: %v", err)
+	}
+
+	// EnvFileProvider.Load sets PORT in the process environment through
+	// godotenv.Overload, and t.Setenv was never called for it, so nothing
+	// restores it automatically; clean up by hand.
+	t.Cleanup(func() { os.Unsetenv("PORT") })
+
+	mapping := ConfigMapping{"Port": "PORT"}
+	service := NewWithProviders(mapping,
+		NewYAMLFileProvider(yamlPath, FileMapping{"Port": "port"}),
+		NewEnvFileProvider(envPath),
+	)
+
+	if err := service.LoadFromProviders(); err != nil {
+		errorMessage = fmt.Sprintf("LoadFromProviders() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	port, err := service.Get("Port")
+	if err != nil {
+		errorMessage = fmt.Sprintf("Get() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if port != "9090" {
+		errorMessage = fmt.Sprintf("expected the later EnvFileProvider (9090) to win over the earlier YAMLFileProvider (8080), got %q", port)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ a later provider in the chain overrides an earlier one for the same key\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
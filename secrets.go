@@ -0,0 +1,159 @@
+package ft_config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrSecretResolution wraps any error returned by a SecretResolver, so
+// callers can distinguish resolver failures with errors.Is(err,
+// ErrSecretResolution).
+var ErrSecretResolution = errors.New("ft_config: secret resolution failed")
+
+// SecretResolver resolves a raw environment value that references a secret
+// into its actual value. Service.Load and Service.LoadFromProviders consult
+// every registered resolver for each value they load; the first resolver to
+// claim a value wins, and the corresponding key is marked secret
+// automatically.
+type SecretResolver interface {
+	// Resolve reports whether it handles value (e.g. by recognizing a
+	// prefix) and, if so, returns the resolved secret or a wrapped error.
+	Resolve(value string) (resolved string, handled bool, err error)
+}
+
+// FileSecretResolver resolves values of the form "file:/path/to/secret" by
+// reading the referenced file and trimming surrounding whitespace.
+type FileSecretResolver struct {
+	// Prefix is the value prefix that marks a file reference. Defaults to
+	// "file:" when constructed via NewFileSecretResolver.
+	Prefix string
+}
+
+// NewFileSecretResolver creates a FileSecretResolver using the "file:" prefix.
+func NewFileSecretResolver() *FileSecretResolver {
+	return &FileSecretResolver{Prefix: "file:"}
+}
+
+func (r *FileSecretResolver) Resolve(value string) (string, bool, error) {
+	path, ok := strings.CutPrefix(value, r.Prefix)
+	if !ok {
+		return "", false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", true, fmt.Errorf("%w: reading secret file %s: %v", ErrSecretResolution, path, err)
+	}
+
+	return strings.TrimSpace(string(data)), true, nil
+}
+
+// CacheSecretResolver wraps another SecretResolver with an in-process cache,
+// keyed by the raw (unresolved) value, so repeated loads do not re-resolve
+// the same secret reference.
+type CacheSecretResolver struct {
+	resolver SecretResolver
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewCacheSecretResolver wraps resolver with an in-process cache.
+func NewCacheSecretResolver(resolver SecretResolver) *CacheSecretResolver {
+	return &CacheSecretResolver{
+		resolver: resolver,
+		cache:    make(map[string]string),
+	}
+}
+
+func (r *CacheSecretResolver) Resolve(value string) (string, bool, error) {
+	r.mu.Lock()
+	if cached, ok := r.cache[value]; ok {
+		r.mu.Unlock()
+		return cached, true, nil
+	}
+	r.mu.Unlock()
+
+	resolved, handled, err := r.resolver.Resolve(value)
+	if err != nil || !handled {
+		return resolved, handled, err
+	}
+
+	r.mu.Lock()
+	r.cache[value] = resolved
+	r.mu.Unlock()
+
+	return resolved, true, nil
+}
+
+// VaultSecretResolver resolves values of the form "vault://secret/data/path"
+// by issuing an HTTP GET against a Vault KV-v2 style endpoint and reading
+// the "value" field of the returned secret.
+type VaultSecretResolver struct {
+	// Prefix is the value prefix that marks a Vault reference.
+	Prefix string
+	// BaseURL is the Vault server address, e.g. "https://vault.internal:8200".
+	BaseURL string
+	// Token is sent as the X-Vault-Token header.
+	Token string
+	// Client performs the HTTP request. Defaults to http.DefaultClient when
+	// constructed via NewVaultSecretResolver.
+	Client *http.Client
+}
+
+// NewVaultSecretResolver creates a VaultSecretResolver for baseURL using
+// the "vault://" prefix.
+func NewVaultSecretResolver(baseURL, token string) *VaultSecretResolver {
+	return &VaultSecretResolver{
+		Prefix:  "vault://",
+		BaseURL: baseURL,
+		Token:   token,
+		Client:  http.DefaultClient,
+	}
+}
+
+func (r *VaultSecretResolver) Resolve(value string) (string, bool, error) {
+	path, ok := strings.CutPrefix(value, r.Prefix)
+	if !ok {
+		return "", false, nil
+	}
+
+	url := strings.TrimSuffix(r.BaseURL, "/") + "/v1/" + path
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", true, fmt.Errorf("%w: building request for %s: %v", ErrSecretResolution, path, err)
+	}
+	req.Header.Set("X-Vault-Token", r.Token)
+
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		return "", true, fmt.Errorf("%w: requesting %s: %v", ErrSecretResolution, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", true, fmt.Errorf("%w: %s returned status %d", ErrSecretResolution, path, resp.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", true, fmt.Errorf("%w: decoding response for %s: %v", ErrSecretResolution, path, err)
+	}
+
+	secret, ok := body.Data.Data["value"]
+	if !ok {
+		return "", true, fmt.Errorf("%w: %s response missing \"value\" field", ErrSecretResolution, path)
+	}
+
+	return secret, true, nil
+}
@@ -0,0 +1,258 @@
+package ft_config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestServiceGetAllSafeRedactsSecrets(t *testing.T) {
+	var (
+		testName     = "TestServiceGetAllSafeRedactsSecrets"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Service.GetAllSafe\n")
+	output.WriteString("========================================\n")
+
+	mapping := ConfigMapping{"SupabaseKey": "SUPABASE_KEY", "Port": "PORT"}
+	service := New(mapping)
+
+	if err := service.Set("SupabaseKey", "super-secret"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if err := service.Set("Port", "8080"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	service.MarkSecret("SupabaseKey")
+
+	safe := service.GetAllSafe()
+	if safe["SupabaseKey"] != "***" {
+		errorMessage = fmt.Sprintf("expected SupabaseKey to be redacted, got %q", safe["SupabaseKey"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if safe["Port"] != "8080" {
+		errorMessage = fmt.Sprintf("expected Port to be untouched, got %q", safe["Port"])
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	all := service.GetAll()
+	if all["SupabaseKey"] != "super-secret" {
+		errorMessage = "GetAll() should still return the real value"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+
+	output.WriteString("✓ GetAllSafe() redacts keys marked secret while GetAll() does not\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestFileSecretResolverReadsReferencedFile(t *testing.T) {
+	var (
+		testName     = "TestFileSecretResolverReadsReferencedFile"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing FileSecretResolver.Resolve\n")
+	output.WriteString("========================================\n")
+
+	path := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(path, []byte("super-secret\n"), 0o600); err != nil {
+		t.Fatalf("writing fixture secret file: %v", err)
+	}
+
+	resolver := NewFileSecretResolver()
+
+	resolved, handled, err := resolver.Resolve("file:" + path)
+	if err != nil {
+		errorMessage = fmt.Sprintf("Resolve() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if !handled {
+		errorMessage = "expected Resolve() to handle a file: reference"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+	if resolved != "super-secret" {
+		errorMessage = fmt.Sprintf("expected 'super-secret', got %q", resolved)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	if _, handled, _ := resolver.Resolve("plain-value"); handled {
+		errorMessage = "expected Resolve() to ignore a value without the file: prefix"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+
+	output.WriteString("✓ FileSecretResolver resolves file: references and ignores everything else\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+type countingSecretResolver struct {
+	calls int
+}
+
+func (r *countingSecretResolver) Resolve(value string) (string, bool, error) {
+	r.calls++
+	return "resolved-" + value, true, nil
+}
+
+func TestCacheSecretResolverResolvesOnce(t *testing.T) {
+	var (
+		testName     = "TestCacheSecretResolverResolvesOnce"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing CacheSecretResolver.Resolve\n")
+	output.WriteString("========================================\n")
+
+	inner := &countingSecretResolver{}
+	resolver := NewCacheSecretResolver(inner)
+
+	for i := 0; i < 3; i++ {
+		resolved, handled, err := resolver.Resolve("ref:key")
+		if err != nil {
+			errorMessage = fmt.Sprintf("Resolve() failed: %v", err)
+			recordTestResult(testName, false, output.String(), errorMessage)
+			t.Errorf("%s", errorMessage)
+			return
+		}
+		if !handled || resolved != "resolved-ref:key" {
+			errorMessage = fmt.Sprintf("expected handled resolved-ref:key, got handled=%v value=%q", handled, resolved)
+			recordTestResult(testName, false, output.String(), errorMessage)
+			t.Errorf("%s", errorMessage)
+			return
+		}
+	}
+
+	if inner.calls != 1 {
+		errorMessage = fmt.Sprintf("expected the wrapped resolver to be called once, got %d", inner.calls)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ CacheSecretResolver resolves a given value only once\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestVaultSecretResolverResolvesOverHTTP(t *testing.T) {
+	var (
+		testName     = "TestVaultSecretResolverResolvesOverHTTP"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing VaultSecretResolver.Resolve\n")
+	output.WriteString("========================================\n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/supabase" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data": map[string]string{"value": "vault-secret"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	resolver := NewVaultSecretResolver(server.URL, "test-token")
+
+	resolved, handled, err := resolver.Resolve("vault://secret/data/supabase")
+	if err != nil {
+		errorMessage = fmt.Sprintf("Resolve() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+	if !handled {
+		errorMessage = "expected Resolve() to handle a vault:// reference"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+	if resolved != "vault-secret" {
+		errorMessage = fmt.Sprintf("expected 'vault-secret', got %q", resolved)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ VaultSecretResolver resolves a vault:// reference over HTTP\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+type failingSecretResolver struct{}
+
+func (failingSecretResolver) Resolve(value string) (string, bool, error) {
+	return "", true, fmt.Errorf("%w: boom", ErrSecretResolution)
+}
+
+func TestSecretResolverFailureWrapsErrSecretResolution(t *testing.T) {
+	var (
+		testName     = "TestSecretResolverFailureWrapsErrSecretResolution"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing SecretResolver failure wrapping\n")
+	output.WriteString("========================================\n")
+
+	mapping := ConfigMapping{}
+	service := NewWithProviders(mapping)
+	service.AddSecretResolver(failingSecretResolver{})
+
+	_, err := service.resolveSecret("SupabaseKey", "anything")
+	if !errors.Is(err, ErrSecretResolution) {
+		errorMessage = fmt.Sprintf("expected errors.Is(err, ErrSecretResolution), got: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ resolver failures are distinguishable via errors.Is\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
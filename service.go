@@ -9,9 +9,18 @@ import (
 
 // Service manages configuration loading and access.
 type Service struct {
-	mapping ConfigMapping
-	config  *Config
-	mu      sync.RWMutex
+	mapping      ConfigMapping
+	providers    []Provider
+	resolvers    []SecretResolver
+	schema       map[string]FieldRule
+	lastLoadPath string
+	config       *Config
+	logger       Logger
+	mu           sync.RWMutex
+
+	changeSubs map[uint64]ChangeFunc
+	reloadSubs map[uint64]ReloadFunc
+	nextSubID  uint64
 }
 
 // New creates a new configuration service with the provided mapping.
@@ -36,6 +45,81 @@ func New(mapping ConfigMapping) *Service {
 	}
 }
 
+// NewWithProviders creates a new configuration service that resolves its
+// values from a chain of providers instead of a single .env file. Call
+// LoadFromProviders to populate it.
+//
+// Example:
+//
+//	service := ft_config.NewWithProviders(mapping,
+//	    ft_config.NewYAMLFileProvider("config.yaml", ft_config.FileMapping{
+//	        "DatabaseURL": "database.url",
+//	    }),
+//	    ft_config.NewEnvFileProvider(".env"),
+//	    ft_config.NewOSEnvProvider(),
+//	)
+//	err := service.LoadFromProviders()
+func NewWithProviders(mapping ConfigMapping, providers ...Provider) *Service {
+	logInfof("NewWithProviders", "Creating new config service with %d mappings and %d providers", len(mapping), len(providers))
+
+	return &Service{
+		mapping:   mapping,
+		providers: providers,
+		config: &Config{
+			values: make(map[string]string),
+		},
+	}
+}
+
+// LoadFromProviders resolves configuration values from each registered
+// provider in declared order. Providers later in the chain override values
+// set by earlier ones; every override is logged.
+//
+// Example:
+//
+//	err := service.LoadFromProviders()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (s *Service) LoadFromProviders() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.loadFromProvidersLocked()
+}
+
+// loadFromProvidersLocked is the body of LoadFromProviders; callers must
+// already hold s.mu.
+func (s *Service) loadFromProvidersLocked() error {
+	if len(s.providers) == 0 {
+		return ErrNoProviders
+	}
+
+	for _, provider := range s.providers {
+		values, err := provider.Load(s.mapping)
+		if err != nil {
+			logError("LoadFromProviders", err)
+			return err
+		}
+
+		for key, value := range values {
+			resolved, err := s.resolveSecret(key, value)
+			if err != nil {
+				logError("LoadFromProviders", err)
+				return err
+			}
+
+			if existing, exists := s.config.values[key]; exists && existing != resolved {
+				logInfof("LoadFromProviders", "Overriding %s: %q -> %q", key, s.config.logValue(key, existing), s.config.logValue(key, resolved))
+			}
+			s.config.values[key] = resolved
+		}
+	}
+
+	logInfof("LoadFromProviders", "Successfully loaded configuration from %d providers", len(s.providers))
+	return s.validateLocked(s.config.values)
+}
+
 // Load loads environment variables from a .env file.
 // Only variables defined in the ConfigMapping will be loaded.
 //
@@ -46,31 +130,78 @@ func New(mapping ConfigMapping) *Service {
 //	    log.Fatal(err)
 //	}
 func (s *Service) Load(filePath string) error {
-	logInfof("Load", "Loading environment variables from: %s", filePath)
-
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.log().Info("Load", "loading environment variables", "file", filePath)
+
+	loadedCount, err := s.loadLocked(filePath)
+	if err != nil {
+		return err
+	}
+
+	if err := s.validateLocked(s.config.values); err != nil {
+		return err
+	}
+
+	s.lastLoadPath = filePath
+	s.log().Info("Load", "loaded configuration", "file", filePath, "loaded", loadedCount, "total", len(s.mapping))
+	return nil
+}
+
+// loadLocked is the body of Load; callers must already hold s.mu. It
+// returns the number of mapped keys it found a value for.
+func (s *Service) loadLocked(filePath string) (int, error) {
 	// Load .env file
 	err := godotenv.Load(filePath)
 	if err != nil {
-		logError("Load", err)
-		return ErrLoadEnv
+		s.log().Error("Load", err, "file", filePath)
+		return 0, ErrLoadEnv
 	}
 
 	// Load mapped environment variables
 	loadedCount := 0
 	for configKey, envKey := range s.mapping {
 		if envValue, exists := os.LookupEnv(envKey); exists {
-			s.config.values[configKey] = envValue
+			resolved, err := s.resolveSecret(configKey, envValue)
+			if err != nil {
+				s.log().Error("Load", err, "key", configKey)
+				return loadedCount, err
+			}
+
+			s.config.values[configKey] = resolved
 			loadedCount++
-			logInfof("Load", "Loaded %s -> %s", envKey, configKey)
-		} else {
-			logInfof("Load", "Environment variable not found: %s", envKey)
 		}
 	}
 
-	logInfof("Load", "Successfully loaded %d/%d configuration values", loadedCount, len(s.mapping))
+	return loadedCount, nil
+}
+
+// reloadLocked re-reads filePath via godotenv.Overload rather than Load, so
+// that values already present in the process environment from an earlier
+// Load are refreshed with the file's current contents. It is used by Watch,
+// which would otherwise never observe a changed value for a key the
+// process environment already holds. Callers must already hold s.mu.
+func (s *Service) reloadLocked(filePath string) error {
+	if err := godotenv.Overload(filePath); err != nil {
+		logError("Load", err)
+		return ErrLoadEnv
+	}
+
+	for configKey, envKey := range s.mapping {
+		envValue, exists := os.LookupEnv(envKey)
+		if !exists {
+			continue
+		}
+
+		resolved, err := s.resolveSecret(configKey, envValue)
+		if err != nil {
+			logError("Load", err)
+			return err
+		}
+		s.config.values[configKey] = resolved
+	}
+
 	return nil
 }
 
@@ -145,7 +276,14 @@ func (s *Service) Set(key, value string) error {
 	defer s.mu.Unlock()
 
 	s.config.values[key] = value
-	logInfof("Set", "Set %s = %s", key, value)
+	s.log().Info("Set", "set configuration value", "key", key, "value", s.config.logValue(key, value))
+
+	if rule, ok := s.schema[key]; ok {
+		if violations := validateField(key, value, true, rule); len(violations) > 0 {
+			return &ValidationError{Violations: violations}
+		}
+	}
+
 	return nil
 }
 
@@ -159,7 +297,7 @@ func (s *Service) Delete(key string) {
 	defer s.mu.Unlock()
 
 	delete(s.config.values, key)
-	logInfof("Delete", "Deleted key: %s", key)
+	s.log().Info("Delete", "deleted configuration key", "key", key)
 }
 
 // Clear removes all configuration values.
@@ -172,7 +310,7 @@ func (s *Service) Clear() {
 	defer s.mu.Unlock()
 
 	s.config.values = make(map[string]string)
-	logInfo("Clear", "Cleared all configuration values")
+	s.log().Info("Clear", "cleared all configuration values")
 }
 
 // Config returns the Config struct for direct field access.
@@ -188,4 +326,90 @@ func (s *Service) Config() *Config {
 	defer s.mu.RUnlock()
 
 	return s.config
-}
\ No newline at end of file
+}
+
+// GetAllSafe returns all configuration key-value pairs, with any value
+// marked secret replaced by a redacted placeholder.
+//
+// Example:
+//
+//	safe := service.GetAllSafe()
+//	log.Printf("config: %v", safe)
+func (s *Service) GetAllSafe() map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.config.GetAllSafe()
+}
+
+// MarkSecret flags key as holding a secret value, so GetAllSafe, Config's
+// String/MarshalJSON, and log output redact it from then on.
+//
+// Example:
+//
+//	service.MarkSecret("SupabaseKey")
+func (s *Service) MarkSecret(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.config.markSecret(key)
+}
+
+// AddSecretResolver registers a SecretResolver that Load and
+// LoadFromProviders consult for every value they load. Resolvers run in
+// registration order; the first one that claims a value wins, and that
+// key is marked secret automatically.
+//
+// Example:
+//
+//	service.AddSecretResolver(ft_config.NewFileSecretResolver())
+func (s *Service) AddSecretResolver(resolver SecretResolver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resolvers = append(s.resolvers, resolver)
+}
+
+// SetLogger overrides the Logger this Service uses for Load, Set, Delete,
+// and Clear. Pass NoopLogger{} to silence those events for a single Service
+// independently of the package-level EnableLogging/DisableLogging switch,
+// or NewSlogLogger to
+// route its events through log/slog. A nil logger restores the package
+// default.
+//
+// Example:
+//
+//	service.SetLogger(ft_config.NewSlogLogger(slog.Default()))
+func (s *Service) SetLogger(logger Logger) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger = logger
+}
+
+// log returns the Logger this Service should use: the one set via
+// SetLogger, or the package default.
+func (s *Service) log() Logger {
+	if s.logger != nil {
+		return s.logger
+	}
+	return defaultLogger
+}
+
+// resolveSecret runs value through each registered SecretResolver in order.
+// The first resolver that claims it wins and configKey is marked secret; if
+// none do, value is returned unchanged.
+func (s *Service) resolveSecret(configKey, value string) (string, error) {
+	for _, resolver := range s.resolvers {
+		resolved, handled, err := resolver.Resolve(value)
+		if err != nil {
+			return "", err
+		}
+		if handled {
+			s.config.markSecret(configKey)
+			return resolved, nil
+		}
+	}
+
+	return value, nil
+}
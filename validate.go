@@ -0,0 +1,220 @@
+package ft_config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FieldRule declares the constraints a configuration value must satisfy.
+// Any number of checks may be combined on a single rule; every one of them
+// is evaluated, so a value can fail several checks at once.
+type FieldRule struct {
+	Required bool
+	Pattern  *regexp.Regexp
+	OneOf    []string
+	MinLen   int
+	MaxLen   int
+	IntRange [2]int
+	URL      bool
+	Custom   func(string) error
+}
+
+// FieldViolation describes a single rule a configuration value failed.
+type FieldViolation struct {
+	Key    string
+	Rule   string
+	Value  string
+	Reason string
+}
+
+func (v *FieldViolation) Error() string {
+	return fmt.Sprintf("%s: %s", v.Key, v.Reason)
+}
+
+// ValidationError aggregates every FieldViolation found while validating a
+// configuration. It implements Unwrap() []error so callers can errors.As a
+// specific *FieldViolation out of a failed Load or Set.
+type ValidationError struct {
+	Violations []*FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		reasons[i] = v.Error()
+	}
+	return fmt.Sprintf("validation failed: %s", strings.Join(reasons, "; "))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Violations))
+	for i, v := range e.Violations {
+		errs[i] = v
+	}
+	return errs
+}
+
+// SetSchema registers validation rules for configuration keys. Load and Set
+// run every applicable rule afterwards and, on violation, return a
+// *ValidationError aggregating all failures.
+//
+// Example:
+//
+//	service.SetSchema(map[string]ft_config.FieldRule{
+//	    "Env":  {OneOf: []string{"dev", "staging", "prod"}},
+//	    "Port": {IntRange: [2]int{1, 65535}},
+//	})
+func (s *Service) SetSchema(schema map[string]FieldRule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.schema = schema
+}
+
+// validateLocked checks every key with a registered rule against values,
+// returning a *ValidationError if any rule fails. Callers must hold s.mu.
+func (s *Service) validateLocked(values map[string]string) error {
+	if len(s.schema) == 0 {
+		return nil
+	}
+
+	var violations []*FieldViolation
+	for key, rule := range s.schema {
+		value, exists := values[key]
+		violations = append(violations, validateField(key, value, exists, rule)...)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: violations}
+}
+
+// validateField runs a single FieldRule against value, returning every
+// violation it produced. exists distinguishes a missing key from a key
+// whose value happens to be the empty string.
+func validateField(key, value string, exists bool, rule FieldRule) []*FieldViolation {
+	if rule.Required && !exists {
+		return []*FieldViolation{{Key: key, Rule: "required", Value: value, Reason: "required value is missing"}}
+	}
+	if !exists {
+		return nil
+	}
+
+	var violations []*FieldViolation
+
+	if rule.Pattern != nil && !rule.Pattern.MatchString(value) {
+		violations = append(violations, &FieldViolation{
+			Key: key, Rule: "pattern", Value: value,
+			Reason: fmt.Sprintf("does not match pattern %s", rule.Pattern.String()),
+		})
+	}
+
+	if len(rule.OneOf) > 0 && !containsString(rule.OneOf, value) {
+		violations = append(violations, &FieldViolation{
+			Key: key, Rule: "oneof", Value: value,
+			Reason: fmt.Sprintf("must be one of %s", strings.Join(rule.OneOf, ", ")),
+		})
+	}
+
+	if rule.MinLen > 0 && len(value) < rule.MinLen {
+		violations = append(violations, &FieldViolation{
+			Key: key, Rule: "minlen", Value: value,
+			Reason: fmt.Sprintf("must be at least %d characters", rule.MinLen),
+		})
+	}
+
+	if rule.MaxLen > 0 && len(value) > rule.MaxLen {
+		violations = append(violations, &FieldViolation{
+			Key: key, Rule: "maxlen", Value: value,
+			Reason: fmt.Sprintf("must be at most %d characters", rule.MaxLen),
+		})
+	}
+
+	if rule.IntRange != [2]int{} {
+		n, err := strconv.Atoi(value)
+		switch {
+		case err != nil:
+			violations = append(violations, &FieldViolation{Key: key, Rule: "intrange", Value: value, Reason: "must be an integer"})
+		case n < rule.IntRange[0] || n > rule.IntRange[1]:
+			violations = append(violations, &FieldViolation{
+				Key: key, Rule: "intrange", Value: value,
+				Reason: fmt.Sprintf("must be between %d and %d", rule.IntRange[0], rule.IntRange[1]),
+			})
+		}
+	}
+
+	if rule.URL {
+		if _, err := url.ParseRequestURI(value); err != nil {
+			violations = append(violations, &FieldViolation{Key: key, Rule: "url", Value: value, Reason: "must be a valid URL"})
+		}
+	}
+
+	if rule.Custom != nil {
+		if err := rule.Custom(value); err != nil {
+			violations = append(violations, &FieldViolation{Key: key, Rule: "custom", Value: value, Reason: err.Error()})
+		}
+	}
+
+	return violations
+}
+
+// parseValidateTag parses a `validate` struct tag, e.g.
+// "required,oneof=dev staging prod,url", into a FieldRule.
+func parseValidateTag(tag string) (FieldRule, error) {
+	var rule FieldRule
+
+	for _, part := range strings.Split(tag, ",") {
+		key, value, hasValue := strings.Cut(part, "=")
+
+		switch key {
+		case "required":
+			rule.Required = true
+		case "url":
+			rule.URL = true
+		case "oneof":
+			if hasValue {
+				rule.OneOf = strings.Fields(value)
+			}
+		case "pattern":
+			if hasValue {
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return rule, fmt.Errorf("ft_config: invalid validate pattern %q: %w", value, err)
+				}
+				rule.Pattern = re
+			}
+		case "minlen":
+			if hasValue {
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return rule, fmt.Errorf("ft_config: invalid validate minlen %q: %w", value, err)
+				}
+				rule.MinLen = n
+			}
+		case "maxlen":
+			if hasValue {
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return rule, fmt.Errorf("ft_config: invalid validate maxlen %q: %w", value, err)
+				}
+				rule.MaxLen = n
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
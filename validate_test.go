@@ -0,0 +1,91 @@
+package ft_config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestServiceSetSchemaRejectsInvalidValue(t *testing.T) {
+	var (
+		testName     = "TestServiceSetSchemaRejectsInvalidValue"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Service.SetSchema\n")
+	output.WriteString("========================================\n")
+
+	mapping := ConfigMapping{"Env": "APP_ENV"}
+	service := New(mapping)
+	service.SetSchema(map[string]FieldRule{
+		"Env": {OneOf: []string{"dev", "staging", "prod"}},
+	})
+
+	err := service.Set("Env", "qa")
+	if err == nil {
+		errorMessage = "expected Set() to reject a value outside OneOf"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		errorMessage = fmt.Sprintf("expected a *ValidationError, got: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	var violation *FieldViolation
+	if !errors.As(err, &violation) {
+		errorMessage = "expected errors.As to unwrap a *FieldViolation"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+	if violation.Key != "Env" {
+		errorMessage = fmt.Sprintf("expected violation for key Env, got %q", violation.Key)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Set() rejects a value outside its schema's OneOf, as a *ValidationError\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
+
+func TestServiceSetSchemaAllowsValidValue(t *testing.T) {
+	var (
+		testName     = "TestServiceSetSchemaAllowsValidValue"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Service.SetSchema with a valid value\n")
+	output.WriteString("========================================\n")
+
+	mapping := ConfigMapping{"Port": "PORT"}
+	service := New(mapping)
+	service.SetSchema(map[string]FieldRule{
+		"Port": {IntRange: [2]int{1, 65535}},
+	})
+
+	if err := service.Set("Port", "8080"); err != nil {
+		errorMessage = fmt.Sprintf("Set() failed for a value within range: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Errorf("%s", errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Set() allows a value that satisfies its schema\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}
@@ -0,0 +1,253 @@
+package ft_config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// ChangeFunc is called once per modified key when Watch detects a reload.
+type ChangeFunc func(key, oldValue, newValue string)
+
+// ReloadFunc is called once per reload, after all ChangeFunc subscribers,
+// with a full snapshot of the configuration.
+type ReloadFunc func(snapshot map[string]string)
+
+// watchDebounce is how long Watch waits after the most recent filesystem
+// event in a burst before re-loading (each new event restarts the wait), to
+// coalesce editor rename-on-save patterns into a single reload.
+const watchDebounce = 100 * time.Millisecond
+
+// OnChange registers fn to be called with the old and new value whenever
+// Watch detects that a key changed. It returns an unsubscribe function.
+func (s *Service) OnChange(fn ChangeFunc) (unsubscribe func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.changeSubs == nil {
+		s.changeSubs = make(map[uint64]ChangeFunc)
+	}
+
+	id := s.nextSubID
+	s.nextSubID++
+	s.changeSubs[id] = fn
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.changeSubs, id)
+	}
+}
+
+// OnReload registers fn to be called with a full snapshot of the
+// configuration after every Watch reload. It returns an unsubscribe
+// function.
+func (s *Service) OnReload(fn ReloadFunc) (unsubscribe func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.reloadSubs == nil {
+		s.reloadSubs = make(map[uint64]ReloadFunc)
+	}
+
+	id := s.nextSubID
+	s.nextSubID++
+	s.reloadSubs[id] = fn
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.reloadSubs, id)
+	}
+}
+
+// Watch watches the sources behind the last Load/LoadFromProviders call
+// (the .env file and any file-backed providers) for changes. On every
+// change it re-runs the original load, diffs the result against the
+// previous values, and dispatches OnChange/OnReload to subscribers. Watch
+// blocks until ctx is cancelled or the watcher's event or error channel is
+// closed, and then returns nil. Errors the watcher reports while running,
+// and failed reloads, are logged and do not stop it.
+//
+// Example:
+//
+//	unsubscribe := service.OnChange(func(key, old, new string) {
+//	    log.Printf("%s changed: %s -> %s", key, old, new)
+//	})
+//	defer unsubscribe()
+//	go service.Watch(ctx)
+func (s *Service) Watch(ctx context.Context) error {
+	paths := s.watchedPaths()
+	if len(paths) == 0 {
+		return ErrNoMapping
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("ft_config: creating watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	for _, path := range paths {
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("ft_config: watching %s: %w", path, err)
+		}
+	}
+
+	var debounce *time.Timer
+	pending := make(chan struct{}, 1)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			// Editors frequently replace the file on save rather than
+			// writing it in place; re-add the watch so we keep receiving
+			// events for it.
+			if event.Op&(fsnotify.Rename|fsnotify.Remove) != 0 {
+				_ = watcher.Add(event.Name)
+			}
+
+			notify := func() {
+				select {
+				case pending <- struct{}{}:
+				default:
+				}
+			}
+			if debounce == nil {
+				debounce = time.AfterFunc(watchDebounce, notify)
+			} else {
+				debounce.Reset(watchDebounce)
+			}
+
+		case <-pending:
+			debounce = nil
+			if err := s.reload(); err != nil {
+				logError("Watch", err)
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logError("Watch", err)
+		}
+	}
+}
+
+// reload re-runs the original Load/LoadFromProviders call, then dispatches
+// OnChange/OnReload to subscribers. Callbacks run outside the service lock,
+// against a snapshot, so they can safely call Get/GetAll themselves.
+func (s *Service) reload() error {
+	s.mu.Lock()
+
+	before := make(map[string]string, len(s.config.values))
+	for k, v := range s.config.values {
+		before[k] = v
+	}
+
+	var err error
+	switch {
+	case len(s.providers) > 0:
+		err = s.loadFromProvidersLocked()
+	case s.lastLoadPath != "":
+		err = s.reloadLocked(s.lastLoadPath)
+	}
+
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+
+	changes := diffValues(before, s.config.values)
+	snapshot := s.config.GetAll()
+
+	changeFns := make([]ChangeFunc, 0, len(s.changeSubs))
+	for _, fn := range s.changeSubs {
+		changeFns = append(changeFns, fn)
+	}
+	reloadFns := make([]ReloadFunc, 0, len(s.reloadSubs))
+	for _, fn := range s.reloadSubs {
+		reloadFns = append(reloadFns, fn)
+	}
+
+	s.mu.Unlock()
+
+	for _, change := range changes {
+		for _, fn := range changeFns {
+			fn(change.key, change.old, change.new)
+		}
+	}
+	for _, fn := range reloadFns {
+		fn(snapshot)
+	}
+
+	return nil
+}
+
+// watchedPaths returns the files Watch should monitor: the .env file from
+// the last Load call, plus the paths of any file-backed providers.
+func (s *Service) watchedPaths() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var paths []string
+	if s.lastLoadPath != "" {
+		paths = append(paths, s.lastLoadPath)
+	}
+
+	for _, provider := range s.providers {
+		switch p := provider.(type) {
+		case *EnvFileProvider:
+			paths = append(paths, p.Path)
+		case *YAMLFileProvider:
+			paths = append(paths, p.Path)
+		case *JSONFileProvider:
+			paths = append(paths, p.Path)
+		}
+	}
+
+	return paths
+}
+
+// valueChange describes a single key whose value differed between two
+// snapshots.
+type valueChange struct {
+	key, old, new string
+}
+
+// diffValues returns, in sorted key order, every key whose value differs
+// between before and after.
+func diffValues(before, after map[string]string) []valueChange {
+	keys := make(map[string]struct{}, len(before)+len(after))
+	for k := range before {
+		keys[k] = struct{}{}
+	}
+	for k := range after {
+		keys[k] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var changes []valueChange
+	for _, k := range sorted {
+		if before[k] != after[k] {
+			changes = append(changes, valueChange{key: k, old: before[k], new: after[k]})
+		}
+	}
+
+	return changes
+}
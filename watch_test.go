@@ -0,0 +1,78 @@
+package ft_config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestServiceWatchDetectsChange(t *testing.T) {
+	var (
+		testName     = "TestServiceWatchDetectsChange"
+		output       bytes.Buffer
+		errorMessage string
+	)
+
+	output.WriteString("\n========================================\n")
+	output.WriteString("Testing Service.Watch\n")
+	output.WriteString("========================================\n")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".env")
+	if err := os.WriteFile(path, []byte("WATCH_TEST_PORT=8080\n"), 0o644); err != nil {
+		t.Fatalf("writing initial .env: %v", err)
+	}
+	t.Cleanup(func() { os.Unsetenv("WATCH_TEST_PORT") })
+
+	mapping := ConfigMapping{"Port": "WATCH_TEST_PORT"}
+	service := New(mapping)
+
+	if err := service.Load(path); err != nil {
+		errorMessage = fmt.Sprintf("Load() failed: %v", err)
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Fatalf("%s", errorMessage)
+		return
+	}
+
+	changed := make(chan [3]string, 1)
+	unsubscribe := service.OnChange(func(key, old, new string) {
+		changed <- [3]string{key, old, new}
+	})
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go service.Watch(ctx)
+
+	// Give the watcher time to register before mutating the file.
+	time.Sleep(200 * time.Millisecond)
+
+	if err := os.WriteFile(path, []byte("WATCH_TEST_PORT=9090\n"), 0o644); err != nil {
+		t.Fatalf("rewriting .env: %v", err)
+	}
+
+	select {
+	case change := <-changed:
+		if change[0] != "Port" || change[1] != "8080" || change[2] != "9090" {
+			errorMessage = fmt.Sprintf("unexpected change event: %+v", change)
+			recordTestResult(testName, false, output.String(), errorMessage)
+			t.Errorf("%s", errorMessage)
+			return
+		}
+	case <-time.After(2 * time.Second):
+		errorMessage = "timed out waiting for OnChange callback"
+		recordTestResult(testName, false, output.String(), errorMessage)
+		t.Error(errorMessage)
+		return
+	}
+
+	output.WriteString("✓ Watch() dispatches OnChange when the .env file is rewritten\n")
+	output.WriteString("========================================\n")
+
+	recordTestResult(testName, true, output.String(), "")
+}